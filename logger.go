@@ -4,49 +4,99 @@
 package logger
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
 	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
 )
 
 // Level describes the chosen log level
 type Level int
 
 type NotePad struct {
-	Handle io.Writer
 	Level  Level
 	Prefix string
-	Logger *log.Logger
+
+	handle    io.Writer
+	stdLogger *log.Logger
+
+	outputThreshold *Level
+	logThreshold    *Level
+	mu              *sync.RWMutex
 }
 
 // checkLevel exists to prevent calling underlying logger methods when not needed.
+// outputThreshold/logThreshold/mu are only populated by NewNotepad; a NotePad
+// built as a zero value falls back to DefaultStdoutThreshold/DefaultLogThreshold
+// and skips locking, since nothing else holds a reference to it.
 func (n *NotePad) checkLevel() bool {
+	if n.mu != nil {
+		n.mu.RLock()
+		defer n.mu.RUnlock()
+	}
+	outputThreshold, logThreshold := DefaultStdoutThreshold, DefaultLogThreshold
+	if n.outputThreshold != nil {
+		outputThreshold = *n.outputThreshold
+	}
+	if n.logThreshold != nil {
+		logThreshold = *n.logThreshold
+	}
 	if n.Level < outputThreshold && n.Level < logThreshold {
 		return false
 	}
 	return true
 }
 
+// logger snapshots the current *log.Logger under a read lock, so callers
+// never read it concurrently with a Set* call rebuilding it.
+func (n *NotePad) logger() *log.Logger {
+	if n.mu != nil {
+		n.mu.RLock()
+		defer n.mu.RUnlock()
+	}
+	return n.stdLogger
+}
+
+// Handle returns the io.Writer this NotePad currently writes through, under
+// a read lock so it is never read concurrently with a Set* call rebuilding
+// it. Mirrors the locked accessors on Notepad, for the same reason: the
+// field it snapshots is rebuilt by initialize() while other goroutines may
+// be calling a Set* method.
+func (n *NotePad) Handle() io.Writer {
+	if n.mu != nil {
+		n.mu.RLock()
+		defer n.mu.RUnlock()
+	}
+	return n.handle
+}
+
 func (n *NotePad) Print(v ...interface{}) {
 	if ok := n.checkLevel(); !ok {
 		return
 	}
-	n.Logger.Print(v...)
+	n.logger().Print(v...)
 }
 
 func (n *NotePad) Printf(format string, v ...interface{}) {
 	if ok := n.checkLevel(); !ok {
 		return
 	}
-	n.Logger.Printf(format, v...)
+	n.logger().Printf(format, v...)
 }
 
 func (n *NotePad) Println(v ...interface{}) {
 	if ok := n.checkLevel(); !ok {
 		return
 	}
-	n.Logger.Println(v...)
+	n.logger().Println(v...)
 }
 
 // Fatal is equivalent to l.Print() followed by a call to os.Exit(1).
@@ -54,7 +104,7 @@ func (n *NotePad) Fatal(v ...interface{}) {
 	if ok := n.checkLevel(); !ok {
 		return
 	}
-	n.Logger.Fatal(v...)
+	n.logger().Fatal(v...)
 }
 
 // Fatalf is equivalent to l.Printf() followed by a call to os.Exit(1).
@@ -62,7 +112,7 @@ func (n *NotePad) Fatalf(format string, v ...interface{}) {
 	if ok := n.checkLevel(); !ok {
 		return
 	}
-	n.Logger.Fatalf(format, v...)
+	n.logger().Fatalf(format, v...)
 }
 
 // Fatalln is equivalent to l.Println() followed by a call to os.Exit(1).
@@ -70,7 +120,7 @@ func (n *NotePad) Fatalln(v ...interface{}) {
 	if ok := n.checkLevel(); !ok {
 		return
 	}
-	n.Logger.Fatalln(v...)
+	n.logger().Fatalln(v...)
 }
 
 // Panic is equivalent to l.Print() followed by a call to panic().
@@ -78,7 +128,7 @@ func (n *NotePad) Panic(v ...interface{}) {
 	if ok := n.checkLevel(); !ok {
 		return
 	}
-	n.Logger.Panic(v...)
+	n.logger().Panic(v...)
 }
 
 // Panicf is equivalent to l.Printf() followed by a call to panic().
@@ -86,7 +136,7 @@ func (n *NotePad) Panicf(format string, v ...interface{}) {
 	if ok := n.checkLevel(); !ok {
 		return
 	}
-	n.Logger.Panicf(format, v...)
+	n.logger().Panicf(format, v...)
 }
 
 // Panicln is equivalent to l.Println() followed by a call to panic().
@@ -94,7 +144,31 @@ func (n *NotePad) Panicln(v ...interface{}) {
 	if ok := n.checkLevel(); !ok {
 		return
 	}
-	n.Logger.Panicln(v...)
+	n.logger().Panicln(v...)
+}
+
+// LogCounter is an io.Writer that discards its input and atomically counts
+// the number of log records written to it. initialize() pairs one with each
+// NotePad's handle through io.MultiWriter, so callers can export "records since start"
+// metrics or assert in tests that a code path logged exactly N times without
+// parsing log output.
+type LogCounter struct {
+	count uint64
+}
+
+// Count returns the number of times Write has been called.
+func (c *LogCounter) Count() uint64 {
+	return atomic.LoadUint64(&c.count)
+}
+
+// Reset sets the counter back to zero.
+func (c *LogCounter) Reset() {
+	atomic.StoreUint64(&c.count, 0)
+}
+
+func (c *LogCounter) Write(p []byte) (int, error) {
+	atomic.AddUint64(&c.count, 1)
+	return len(p), nil
 }
 
 const (
@@ -111,29 +185,325 @@ const (
 	DefaultStdoutThreshold = LevelInfo
 )
 
-var (
-	logger *log.Logger
+// Notepad is a self-contained set of the seven level loggers along with the
+// thresholds and handles that control them. It lets callers run several
+// independently-configured loggers in the same process (for example, one per
+// subsystem, or one per test) instead of sharing the package-level globals.
+type Notepad struct {
+	trace    *NotePad
+	debug    *NotePad
+	info     *NotePad
+	warn     *NotePad
+	error    *NotePad
+	critical *NotePad
+	fatal    *NotePad
+
+	notePads       []*NotePad
+	counters       map[Level]*LogCounter
+	feedbackLogger *log.Logger
+	logFilePath    string
+
+	logThreshold    Level
+	outputThreshold Level
+
+	logHandle  io.Writer
+	outHandle  io.Writer
+	bothHandle io.Writer
+
+	flag int
+
+	// mu guards logThreshold, outputThreshold, the handles above and every
+	// NotePad's stdLogger/handle, all of which are rebuilt together whenever a
+	// Set* method is called. Without it, a goroutine rotating the log file
+	// or toggling the threshold at runtime races with concurrent Print* calls.
+	// The handles and flag are unexported and reached only through the
+	// locked accessors below, so there is no plain field a caller could read
+	// without synchronization.
+	mu sync.RWMutex
+}
+
+// NewNotepad creates a new Notepad. outThreshold and logThreshold set the
+// initial stdout/log thresholds, outHandle and logHandle are the underlying
+// writers, prefix is prepended to every level tag (e.g. "[I]: ") and flag is
+// passed straight through to the underlying log.Logger instances.
+func NewNotepad(outThreshold, logThreshold Level, outHandle, logHandle io.Writer, prefix string, flag int) *Notepad {
+	n := &Notepad{
+		outputThreshold: outThreshold,
+		logThreshold:    logThreshold,
+		logHandle:       logHandle,
+		outHandle:       outHandle,
+		flag:            flag,
+	}
+
+	n.trace = &NotePad{Level: LevelTrace, Prefix: prefix + "[T]: ", outputThreshold: &n.outputThreshold, logThreshold: &n.logThreshold, mu: &n.mu}
+	n.debug = &NotePad{Level: LevelDebug, Prefix: prefix + "[D]: ", outputThreshold: &n.outputThreshold, logThreshold: &n.logThreshold, mu: &n.mu}
+	n.info = &NotePad{Level: LevelInfo, Prefix: prefix + "[I]: ", outputThreshold: &n.outputThreshold, logThreshold: &n.logThreshold, mu: &n.mu}
+	n.warn = &NotePad{Level: LevelWarn, Prefix: prefix + "[W]: ", outputThreshold: &n.outputThreshold, logThreshold: &n.logThreshold, mu: &n.mu}
+	n.error = &NotePad{Level: LevelError, Prefix: prefix + "[E]: ", outputThreshold: &n.outputThreshold, logThreshold: &n.logThreshold, mu: &n.mu}
+	n.critical = &NotePad{Level: LevelCritical, Prefix: prefix + "[C]: ", outputThreshold: &n.outputThreshold, logThreshold: &n.logThreshold, mu: &n.mu}
+	n.fatal = &NotePad{Level: LevelFatal, Prefix: prefix + "[F]: ", outputThreshold: &n.outputThreshold, logThreshold: &n.logThreshold, mu: &n.mu}
+
+	n.notePads = []*NotePad{n.trace, n.debug, n.info, n.warn, n.error, n.critical, n.fatal}
+
+	n.counters = make(map[Level]*LogCounter, len(n.notePads))
+	for _, np := range n.notePads {
+		n.counters[np.Level] = &LogCounter{}
+	}
+
+	n.mu.Lock()
+	n.initialize()
+	n.mu.Unlock()
+	return n
+}
+
+func (n *Notepad) Trace() *NotePad    { return n.trace }
+func (n *Notepad) Debug() *NotePad    { return n.debug }
+func (n *Notepad) Info() *NotePad     { return n.info }
+func (n *Notepad) Warn() *NotePad     { return n.warn }
+func (n *Notepad) Error() *NotePad    { return n.error }
+func (n *Notepad) Critical() *NotePad { return n.critical }
+func (n *Notepad) Fatal() *NotePad    { return n.fatal }
+
+// LogHandle returns the writer currently backing the log file side, as set
+// by NewNotepad or the last call to SetLogFile/SetLogHandle/ReopenLogFile.
+func (n *Notepad) LogHandle() io.Writer {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.logHandle
+}
+
+// OutHandle returns the writer currently backing the stdout side.
+func (n *Notepad) OutHandle() io.Writer {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.outHandle
+}
+
+// BothHandle returns the io.MultiWriter combining LogHandle and OutHandle.
+func (n *Notepad) BothHandle() io.Writer {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.bothHandle
+}
+
+// Flag returns the log.Logger flag bits currently in use.
+func (n *Notepad) Flag() int {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.flag
+}
+
+// initialize (re)builds the handle and stdLogger of every NotePad from the
+// Notepad's current thresholds and handles. Callers must hold n.mu for writing.
+func (n *Notepad) initialize() {
+	n.bothHandle = io.MultiWriter(n.logHandle, n.outHandle)
+	for _, np := range n.notePads {
+		if np.Level < n.outputThreshold && np.Level < n.logThreshold {
+			np.handle = ioutil.Discard
+		} else if np.Level >= n.outputThreshold && np.Level >= n.logThreshold {
+			np.handle = n.bothHandle
+		} else if np.Level >= n.outputThreshold && np.Level < n.logThreshold {
+			np.handle = n.outHandle
+		} else {
+			np.handle = n.logHandle
+		}
+		np.handle = io.MultiWriter(np.handle, n.counters[np.Level])
+	}
+
+	for _, np := range n.notePads {
+		np.stdLogger = log.New(np.handle, np.Prefix, n.flag)
+	}
+
+	n.feedbackLogger = log.New(n.logHandle, "[F]: ", n.flag)
+}
+
+// LogCountForLevel returns the number of records written at the given level.
+func (n *Notepad) LogCountForLevel(l Level) uint64 {
+	if c, ok := n.counters[l]; ok {
+		return c.Count()
+	}
+	return 0
+}
+
+// ResetLogCounts resets every level's LogCounter back to zero.
+func (n *Notepad) ResetLogCounts() {
+	for _, c := range n.counters {
+		c.Reset()
+	}
+}
+
+// SetLogThreshold establishes a threshold where anything matching or above will be logged.
+func (n *Notepad) SetLogThreshold(level Level) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.logThreshold = levelCheck(level)
+	n.initialize()
+}
+
+// SetStdoutThreshold establishes a threshold where anything matching or above will be output.
+func (n *Notepad) SetStdoutThreshold(level Level) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.outputThreshold = levelCheck(level)
+	n.initialize()
+}
+
+// SetLogThresholdString parses s and sets the log threshold, so callers
+// reading a level from config don't need their own parsing shim.
+func (n *Notepad) SetLogThresholdString(s string) error {
+	level, err := ParseLevel(s)
+	if err != nil {
+		return err
+	}
+	n.SetLogThreshold(level)
+	return nil
+}
+
+// SetStdoutThresholdString parses s and sets the stdout threshold.
+func (n *Notepad) SetStdoutThresholdString(s string) error {
+	level, err := ParseLevel(s)
+	if err != nil {
+		return err
+	}
+	n.SetStdoutThreshold(level)
+	return nil
+}
+
+// SetLogHandle sets the writer backing the log file side to an arbitrary
+// io.Writer, for callers that already have one (a buffer in tests, a
+// rotating writer, syslog) instead of a filepath. If a previous handle was
+// set via SetLogFile or ReopenLogFile, it is closed, and the path they
+// remembered is cleared so a later ReopenLogFile call doesn't reopen it.
+func (n *Notepad) SetLogHandle(handle io.Writer) {
+	n.mu.Lock()
+	previous, openedFile := n.logHandle, n.logFilePath != ""
+	n.logHandle = handle
+	n.logFilePath = ""
+	n.initialize()
+	n.mu.Unlock()
+
+	if closer, ok := previous.(io.Closer); ok && openedFile && previous != handle {
+		closer.Close()
+	}
+}
+
+// SetOutHandle sets the writer backing the stdout side to an arbitrary
+// io.Writer. The previous handle is never closed: unlike the log side, the
+// out handle is always supplied by the caller (os.Stdout by default).
+func (n *Notepad) SetOutHandle(handle io.Writer) {
+	n.mu.Lock()
+	n.outHandle = handle
+	n.initialize()
+	n.mu.Unlock()
+}
+
+// SetLogFile conveniently sets the log handle to a io.Writer created for the
+// file behind the given filepath. Will only append to this file.
+func (n *Notepad) SetLogFile(path string) error {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_APPEND|os.O_CREATE, 0666)
+	if err != nil {
+		n.critical.Println("Failed to open log file:", path, err)
+		return err
+	}
+	n.mu.Lock()
+	previous := n.logHandle
+	n.logHandle = file
+	n.logFilePath = path
+	n.initialize()
+	n.mu.Unlock()
+
+	if closer, ok := previous.(io.Closer); ok {
+		closer.Close()
+	}
+	return nil
+}
+
+func (n *Notepad) SetLogFlag(flag int) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.flag = flag
+	n.initialize()
+}
+
+// ReopenLogFile re-opens the path last passed to SetLogFile and swaps it in
+// as the new LogHandle, closing the previous file afterwards. This is the
+// pattern external log rotators (logrotate, systemd) expect: they rename or
+// truncate the file on disk, and the process must reopen it by path to avoid
+// writing to a deleted inode.
+func (n *Notepad) ReopenLogFile() error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.logFilePath == "" {
+		return errors.New("logger: no log file configured, call SetLogFile first")
+	}
+
+	file, err := os.OpenFile(n.logFilePath, os.O_RDWR|os.O_APPEND|os.O_CREATE, 0666)
+	if err != nil {
+		return err
+	}
 
-	LogHandle  io.Writer = ioutil.Discard
-	OutHandle  io.Writer = os.Stdout
-	BothHandle io.Writer = io.MultiWriter(LogHandle, OutHandle)
+	previous := n.logHandle
+	n.logHandle = file
+	n.initialize()
 
-	Flag int = log.Ldate | log.Ltime
+	if closer, ok := previous.(io.Closer); ok {
+		closer.Close()
+	}
+	return nil
+}
 
-	TRACE    *NotePad = &NotePad{Level: LevelTrace, Handle: os.Stdout, Logger: logger, Prefix: "[T]: "}
-	DEBUG    *NotePad = &NotePad{Level: LevelDebug, Handle: os.Stdout, Logger: logger, Prefix: "[D]: "}
-	INFO     *NotePad = &NotePad{Level: LevelInfo, Handle: os.Stdout, Logger: logger, Prefix: "[I]: "}
-	WARN     *NotePad = &NotePad{Level: LevelWarn, Handle: os.Stdout, Logger: logger, Prefix: "[W]: "}
-	ERROR    *NotePad = &NotePad{Level: LevelError, Handle: os.Stdout, Logger: logger, Prefix: "[E]: "}
-	CRITICAL *NotePad = &NotePad{Level: LevelCritical, Handle: os.Stdout, Logger: logger, Prefix: "[C]: "}
-	FATAL    *NotePad = &NotePad{Level: LevelFatal, Handle: os.Stdout, Logger: logger, Prefix: "[F]: "}
+var (
+	// std is the default Notepad, backing the package-level TRACE, DEBUG, ...
+	// variables and the Set* functions below. LogHandle/OutHandle/BothHandle/
+	// Flag were package-level io.Writer/int variables before Notepad existed;
+	// they are functions now, so callers that read or assigned them directly
+	// need to switch to these functions and SetLogHandle/SetOutHandle below.
+	std = NewNotepad(DefaultStdoutThreshold, DefaultLogThreshold, os.Stdout, ioutil.Discard, "", log.Ldate|log.Ltime)
 
-	NotePads []*NotePad = []*NotePad{TRACE, DEBUG, INFO, WARN, ERROR, CRITICAL, FATAL}
+	TRACE    = std.Trace()
+	DEBUG    = std.Debug()
+	INFO     = std.Info()
+	WARN     = std.Warn()
+	ERROR    = std.Error()
+	CRITICAL = std.Critical()
+	FATAL    = std.Fatal()
 
-	logThreshold    Level = DefaultLogThreshold
-	outputThreshold Level = DefaultStdoutThreshold
+	NotePads = []*NotePad{TRACE, DEBUG, INFO, WARN, ERROR, CRITICAL, FATAL}
 )
 
+// LogHandle returns the default Notepad's current log handle.
+func LogHandle() io.Writer {
+	return std.LogHandle()
+}
+
+// OutHandle returns the default Notepad's current stdout handle.
+func OutHandle() io.Writer {
+	return std.OutHandle()
+}
+
+// BothHandle returns the default Notepad's current combined handle.
+func BothHandle() io.Writer {
+	return std.BothHandle()
+}
+
+// Flag returns the default Notepad's current log.Logger flag bits.
+func Flag() int {
+	return std.Flag()
+}
+
+// SetLogHandle sets the default Notepad's log handle to an arbitrary
+// io.Writer, as an alternative to the filepath-based SetLogFile.
+func SetLogHandle(handle io.Writer) {
+	std.SetLogHandle(handle)
+}
+
+// SetOutHandle sets the default Notepad's stdout handle to an arbitrary
+// io.Writer.
+func SetOutHandle(handle io.Writer) {
+	std.SetOutHandle(handle)
+}
+
 var LevelMatches = map[string]Level{
 	"TRACE":    LevelTrace,
 	"DEBUG":    LevelDebug,
@@ -145,28 +515,54 @@ var LevelMatches = map[string]Level{
 	"NONE":     LevelNone,
 }
 
-func init() {
-	initialize()
+// String returns the canonical name of the level, as used as a key in LevelMatches.
+func (l Level) String() string {
+	switch l {
+	case LevelTrace:
+		return "TRACE"
+	case LevelDebug:
+		return "DEBUG"
+	case LevelInfo:
+		return "INFO"
+	case LevelWarn:
+		return "WARN"
+	case LevelError:
+		return "ERROR"
+	case LevelCritical:
+		return "CRITICAL"
+	case LevelFatal:
+		return "FATAL"
+	case LevelNone:
+		return "NONE"
+	default:
+		return "UNKNOWN"
+	}
 }
 
-// initialize initializes loggers
-func initialize() {
-	BothHandle = io.MultiWriter(LogHandle, OutHandle)
-	for _, n := range NotePads {
-		if n.Level < outputThreshold && n.Level < logThreshold {
-			n.Handle = ioutil.Discard
-		} else if n.Level >= outputThreshold && n.Level >= logThreshold {
-			n.Handle = BothHandle
-		} else if n.Level >= outputThreshold && n.Level < logThreshold {
-			n.Handle = OutHandle
-		} else {
-			n.Handle = LogHandle
-		}
+// ParseLevel parses a level name (case-insensitive) into a Level, for
+// reading log levels out of config files.
+func ParseLevel(s string) (Level, error) {
+	level, ok := LevelMatches[strings.ToUpper(s)]
+	if !ok {
+		return LevelNone, fmt.Errorf("logger: unknown level %q", s)
 	}
+	return level, nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (l Level) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
 
-	for _, n := range NotePads {
-		n.Logger = log.New(n.Handle, n.Prefix, Flag)
+// UnmarshalText implements encoding.TextUnmarshaler, so a Level field can be
+// decoded directly from YAML/JSON config, e.g. {"log_level":"warn"}.
+func (l *Level) UnmarshalText(text []byte) error {
+	level, err := ParseLevel(string(text))
+	if err != nil {
+		return err
 	}
+	*l = level
+	return nil
 }
 
 // Ensures that the level provided is within the bounds of available levels
@@ -183,30 +579,103 @@ func levelCheck(level Level) Level {
 
 // Establishes a threshold where anything matching or above will be logged
 func SetLogThreshold(level Level) {
-	logThreshold = levelCheck(level)
-	initialize()
+	std.SetLogThreshold(level)
 }
 
 // Establishes a threshold where anything matching or above will be output
 func SetStdoutThreshold(level Level) {
-	outputThreshold = levelCheck(level)
-	initialize()
+	std.SetStdoutThreshold(level)
+}
+
+// SetLogThresholdString parses s and sets the log threshold of the default Notepad.
+func SetLogThresholdString(s string) error {
+	return std.SetLogThresholdString(s)
+}
+
+// SetStdoutThresholdString parses s and sets the stdout threshold of the default Notepad.
+func SetStdoutThresholdString(s string) error {
+	return std.SetStdoutThresholdString(s)
 }
 
 // Conveniently Sets the Log Handle to a io.writer created for the file behind the given filepath
 // Will only append to this file
 func SetLogFile(path string) error {
-	file, err := os.OpenFile(path, os.O_RDWR|os.O_APPEND|os.O_CREATE, 0666)
-	if err != nil {
-		CRITICAL.Println("Failed to open log file:", path, err)
-		return err
-	}
-	LogHandle = file
-	initialize()
-	return nil
+	return std.SetLogFile(path)
 }
 
 func SetLogFlag(flag int) {
-	Flag = flag
-	initialize()
+	std.SetLogFlag(flag)
+}
+
+// ReopenLogFile re-opens the default Notepad's log file in place, for use by
+// external log rotation.
+func ReopenLogFile() error {
+	return std.ReopenLogFile()
+}
+
+// HandleSIGHUPRotation installs a SIGHUP handler that calls ReopenLogFile,
+// matching the rotation signal logrotate and systemd send by convention. It
+// runs until ctx is canceled.
+func HandleSIGHUPRotation(ctx context.Context) {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGHUP)
+
+	go func() {
+		defer signal.Stop(sigCh)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-sigCh:
+				if err := ReopenLogFile(); err != nil {
+					CRITICAL.Println("Failed to reopen log file on SIGHUP:", err)
+				}
+			}
+		}
+	}()
 }
+
+// LogCountForLevel returns the number of records logged at the given level
+// through the default Notepad.
+func LogCountForLevel(l Level) uint64 {
+	return std.LogCountForLevel(l)
+}
+
+// ResetLogCounts resets the default Notepad's per-level counters to zero.
+func ResetLogCounts() {
+	std.ResetLogCounts()
+}
+
+// Feedback writes a message straight to the user-facing OutHandle, with no
+// timestamp or level prefix, while simultaneously logging the same message
+// at INFO level through LogHandle only (the OutHandle copy is never
+// duplicated into the log record). It honors logThreshold, so the file
+// record is skipped if the log threshold is set above INFO.
+type Feedback struct{}
+
+// Println prints to OutHandle and logs the message via INFO to LogHandle.
+func (fb *Feedback) Println(v ...interface{}) {
+	std.mu.RLock()
+	outHandle, logThreshold, feedbackLogger := std.outHandle, std.logThreshold, std.feedbackLogger
+	std.mu.RUnlock()
+
+	fmt.Fprintln(outHandle, v...)
+	if LevelInfo >= logThreshold {
+		feedbackLogger.Println(v...)
+	}
+}
+
+// Printf prints to OutHandle and logs the message via INFO to LogHandle.
+func (fb *Feedback) Printf(format string, v ...interface{}) {
+	std.mu.RLock()
+	outHandle, logThreshold, feedbackLogger := std.outHandle, std.logThreshold, std.feedbackLogger
+	std.mu.RUnlock()
+
+	fmt.Fprintf(outHandle, format, v...)
+	if LevelInfo >= logThreshold {
+		feedbackLogger.Printf(format, v...)
+	}
+}
+
+// FEEDBACK is the package-level Feedback logger backed by the default Notepad.
+var FEEDBACK Feedback
@@ -0,0 +1,301 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// TestConcurrentAccess exercises concurrent Print* calls and concurrent reads
+// of the handle/flag accessors against concurrent reconfiguration (log file
+// rotation, threshold changes) to catch data races. Run with `go test -race`.
+// It uses its own Notepad rather than the package default so it doesn't
+// leave std pointed at a removed temp file for later tests.
+func TestConcurrentAccess(t *testing.T) {
+	tmpfile, err := ioutil.TempFile("", "go-logger-race")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpfile.Name())
+	tmpfile.Close()
+
+	n := NewNotepad(DefaultStdoutThreshold, DefaultLogThreshold, ioutil.Discard, ioutil.Discard, "", log.Ldate|log.Ltime)
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				n.Info().Println("hello")
+			}
+		}
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				_ = n.LogHandle()
+				_ = n.OutHandle()
+				_ = n.BothHandle()
+				_ = n.Flag()
+			}
+		}
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		levels := []Level{LevelDebug, LevelInfo, LevelWarn}
+		for i := 0; i < 100; i++ {
+			if err := n.SetLogFile(tmpfile.Name()); err != nil {
+				t.Error(err)
+			}
+			n.SetLogThreshold(levels[i%len(levels)])
+		}
+		close(done)
+	}()
+
+	wg.Wait()
+}
+
+// TestLogCounter verifies that LogCountForLevel/ResetLogCounts track records
+// written at each level, and ignore records suppressed by the threshold.
+func TestLogCounter(t *testing.T) {
+	n := NewNotepad(LevelWarn, LevelWarn, ioutil.Discard, ioutil.Discard, "", 0)
+
+	n.Warn().Println("warning one")
+	n.Warn().Println("warning two")
+	n.Info().Println("info, below threshold")
+
+	if got := n.LogCountForLevel(LevelWarn); got != 2 {
+		t.Fatalf("expected 2 warnings logged, got %d", got)
+	}
+	if got := n.LogCountForLevel(LevelInfo); got != 0 {
+		t.Fatalf("expected 0 infos logged below threshold, got %d", got)
+	}
+
+	n.ResetLogCounts()
+	if got := n.LogCountForLevel(LevelWarn); got != 0 {
+		t.Fatalf("expected counts reset to 0, got %d", got)
+	}
+}
+
+// TestFeedback verifies that FEEDBACK prints a clean line to OutHandle while
+// also writing a timestamped [F]: record to LogHandle, and that the file
+// record honors logThreshold while the OutHandle copy always prints.
+func TestFeedback(t *testing.T) {
+	var outBuf, logBuf bytes.Buffer
+
+	origOut, origLog := OutHandle(), LogHandle()
+	std.mu.Lock()
+	origLogThreshold := std.logThreshold
+	std.mu.Unlock()
+	defer func() {
+		SetOutHandle(origOut)
+		SetLogHandle(origLog)
+		std.mu.Lock()
+		std.logThreshold = origLogThreshold
+		std.initialize()
+		std.mu.Unlock()
+	}()
+
+	SetOutHandle(&outBuf)
+	SetLogHandle(&logBuf)
+
+	FEEDBACK.Println("hello operator")
+
+	if got := outBuf.String(); got != "hello operator\n" {
+		t.Fatalf("unexpected OutHandle copy: %q", got)
+	}
+	if got := logBuf.String(); !strings.Contains(got, "[F]: ") || !strings.Contains(got, "hello operator") {
+		t.Fatalf("expected a [F]: record in the log, got %q", got)
+	}
+
+	outBuf.Reset()
+	logBuf.Reset()
+
+	std.mu.Lock()
+	std.logThreshold = LevelError
+	std.initialize()
+	std.mu.Unlock()
+
+	FEEDBACK.Println("quiet on disk")
+
+	if got := outBuf.String(); got != "quiet on disk\n" {
+		t.Fatalf("unexpected OutHandle copy: %q", got)
+	}
+	if got := logBuf.String(); got != "" {
+		t.Fatalf("expected no log record once logThreshold is above INFO, got %q", got)
+	}
+}
+
+// TestLevelStringAndParse checks that String/ParseLevel round-trip every
+// entry in LevelMatches, case-insensitively, and that ParseLevel rejects
+// unknown names.
+func TestLevelStringAndParse(t *testing.T) {
+	for name, level := range LevelMatches {
+		if got := level.String(); got != name {
+			t.Errorf("Level(%d).String() = %q, want %q", level, got, name)
+		}
+
+		parsed, err := ParseLevel(strings.ToLower(name))
+		if err != nil {
+			t.Errorf("ParseLevel(%q) returned error: %v", name, err)
+		}
+		if parsed != level {
+			t.Errorf("ParseLevel(%q) = %v, want %v", name, parsed, level)
+		}
+	}
+
+	if _, err := ParseLevel("bogus"); err == nil {
+		t.Fatal("expected an error for an unknown level")
+	}
+}
+
+// TestLevelTextMarshaling checks MarshalText/UnmarshalText, the hooks that
+// let a Level field decode directly from YAML/JSON config.
+func TestLevelTextMarshaling(t *testing.T) {
+	text, err := LevelWarn.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText returned error: %v", err)
+	}
+	if string(text) != "WARN" {
+		t.Fatalf("MarshalText() = %q, want %q", text, "WARN")
+	}
+
+	var l Level
+	if err := l.UnmarshalText([]byte("warn")); err != nil {
+		t.Fatalf("UnmarshalText returned error: %v", err)
+	}
+	if l != LevelWarn {
+		t.Fatalf("UnmarshalText(%q) = %v, want %v", "warn", l, LevelWarn)
+	}
+
+	if err := l.UnmarshalText([]byte("bogus")); err == nil {
+		t.Fatal("expected an error for an unknown level")
+	}
+}
+
+// TestReopenLogFile verifies that ReopenLogFile re-opens the path last
+// passed to SetLogFile, so the process follows a rename/truncate performed
+// by an external log rotator instead of writing to a deleted inode.
+func TestReopenLogFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "go-logger-reopen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := dir + "/test.log"
+
+	n := NewNotepad(LevelNone, LevelInfo, ioutil.Discard, ioutil.Discard, "", 0)
+
+	if err := n.ReopenLogFile(); err == nil {
+		t.Fatal("expected an error before SetLogFile has been called")
+	}
+
+	if err := n.SetLogFile(path); err != nil {
+		t.Fatalf("SetLogFile returned error: %v", err)
+	}
+	n.Info().Println("before rotation")
+
+	if err := os.Rename(path, path+".1"); err != nil {
+		t.Fatalf("failed to simulate rotation: %v", err)
+	}
+
+	if err := n.ReopenLogFile(); err != nil {
+		t.Fatalf("ReopenLogFile returned error: %v", err)
+	}
+	n.Info().Println("after rotation")
+
+	rotated, err := ioutil.ReadFile(path + ".1")
+	if err != nil {
+		t.Fatalf("failed to read rotated file: %v", err)
+	}
+	if !strings.Contains(string(rotated), "before rotation") {
+		t.Fatalf("rotated file missing pre-rotation record: %q", rotated)
+	}
+
+	current, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read current log file: %v", err)
+	}
+	if !strings.Contains(string(current), "after rotation") {
+		t.Fatalf("current log file missing post-rotation record: %q", current)
+	}
+}
+
+// TestHandleSIGHUPRotation verifies that sending SIGHUP to the process
+// triggers ReopenLogFile on the default Notepad. HandleSIGHUPRotation is
+// necessarily tied to the package-level std Notepad (signals are
+// process-wide), so this test saves and restores std's log file state
+// instead of running against an isolated instance.
+func TestHandleSIGHUPRotation(t *testing.T) {
+	dir, err := ioutil.TempDir("", "go-logger-sighup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := dir + "/test.log"
+
+	std.mu.Lock()
+	origLogHandle, origLogFilePath := std.logHandle, std.logFilePath
+	std.mu.Unlock()
+	defer func() {
+		std.mu.Lock()
+		testHandle := std.logHandle
+		std.logHandle = origLogHandle
+		std.logFilePath = origLogFilePath
+		std.initialize()
+		std.mu.Unlock()
+
+		if closer, ok := testHandle.(io.Closer); ok {
+			closer.Close()
+		}
+	}()
+
+	if err := SetLogFile(path); err != nil {
+		t.Fatalf("SetLogFile returned error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	HandleSIGHUPRotation(ctx)
+
+	if err := os.Rename(path, path+".1"); err != nil {
+		t.Fatalf("failed to simulate rotation: %v", err)
+	}
+
+	if err := syscall.Kill(os.Getpid(), syscall.SIGHUP); err != nil {
+		t.Fatalf("failed to send SIGHUP: %v", err)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		if _, err := os.Stat(path); err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("log file was not reopened after SIGHUP")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}